@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// fieldManager is the server-side apply field manager used for every
+// resource this operator applies. Keeping it fixed means re-applying the
+// same desired object is idempotent across reconciles and across
+// controller restarts.
+//
+// applyResource is this operator's only create/update path for owned
+// children, superseding the create-then-get-on-conflict ensureResource
+// helper added in d4f8aa7: that helper only handled create-or-get and had
+// no drift-reconciliation story, so once Deployment/Service/Ingress moved
+// to SSA apply (which already subsumes "create if missing, else converge")
+// ensureResource had no remaining callers and was deleted in 1235407.
+const fieldManager = "webapp-operator"
+
+// applyResource server-side-applies desired, which must have its TypeMeta
+// (APIVersion/Kind) set since SSA serializes the object as-is. Unlike a
+// plain Update, this reconciles every field we set (image, env, ports,
+// replicas, labels, strategy) against spec on every pass instead of only
+// the fields a hand-written diff happens to check. desired is populated
+// with the server's response, including status, so callers can read it
+// back immediately.
+//
+// ForceOwnership is deliberately not set: if another field manager (e.g.
+// kubectl, an HPA) has taken ownership of a field we also manage, Patch
+// returns a conflict error instead of silently overwriting it, and callers
+// surface that back onto the WebApp and retry only on a 30 second requeue.
+func applyResource(ctx context.Context, c client.Client, desired client.Object) error {
+	return c.Patch(ctx, desired, client.Apply, client.FieldOwner(fieldManager))
+}
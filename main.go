@@ -8,12 +8,15 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -33,6 +36,24 @@ type WebAppSpec struct {
 	Replicas int32        `json:"replicas"`
 	Port     int32        `json:"port,omitempty"`
 	Env      []EnvVar     `json:"env,omitempty"`
+	Ingress  *IngressSpec `json:"ingress,omitempty"`
+	// RolloutTimeout bounds how long a Deployment rollout may run before
+	// the Progressing condition is replaced by a Degraded condition (Ready
+	// stays False either way).
+	// Defaults to 5 minutes when unset.
+	RolloutTimeout metav1.Duration `json:"rolloutTimeout,omitempty"`
+	// UpdateStrategy configures the rolling update parameters of the
+	// applied Deployment. Defaults to the Deployment's own defaults
+	// (25%/25%) when unset.
+	UpdateStrategy *UpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// UpdateStrategy mirrors the RollingUpdate knobs of appsv1.DeploymentSpec
+// so WebApp authors can tune rollout pacing without reaching for a raw
+// Deployment.
+type UpdateStrategy struct {
+	MaxSurge       *intstr.IntOrString `json:"maxSurge,omitempty"`
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
 type EnvVar struct {
@@ -40,9 +61,70 @@ type EnvVar struct {
 	Value string `json:"value"`
 }
 
+// IngressSpec configures the Ingress created for a WebApp. It is optional;
+// when nil, no Ingress is created and traffic must reach the Service directly.
+type IngressSpec struct {
+	Host string `json:"host"`
+	Path string `json:"path,omitempty"`
+	// TLSSecretName names a Secret, in the same namespace as the WebApp,
+	// holding the TLS certificate/key for Host. When set but the Secret
+	// does not yet exist, the WebApp surfaces an IngressPending condition
+	// instead of creating a broken Ingress.
+	TLSSecretName    string            `json:"tlsSecretName,omitempty"`
+	IngressClassName *string           `json:"ingressClassName,omitempty"`
+	Annotations      map[string]string `json:"annotations,omitempty"`
+}
+
 type WebAppStatus struct {
 	AvailableReplicas int32              `json:"availableReplicas,omitempty"`
 	Conditions        []metav1.Condition `json:"conditions,omitempty"`
+	// URL is the externally reachable address reported by the admitted
+	// Ingress, once its load balancer has been assigned. Like PodStatuses,
+	// ServiceStatus and ReplicaSetStatus below, it is aggregated by a
+	// dedicated status sub-controller (IngressStatusReconciler), not by
+	// WebAppReconciler itself.
+	URL string `json:"url,omitempty"`
+
+	// RolloutStartedAt marks when the current, not-yet-complete rollout was
+	// first observed. It is cleared once the rollout completes and is used
+	// to evaluate spec.RolloutTimeout.
+	RolloutStartedAt *metav1.Time `json:"rolloutStartedAt,omitempty"`
+	// RolloutGeneration is the Deployment generation RolloutStartedAt was
+	// recorded for. When the observed Deployment generation no longer
+	// matches, a new rollout has started (e.g. the image was updated again
+	// before the previous rollout finished) and the timer is restarted, so
+	// a fresh rollout is never charged for a prior one's elapsed time.
+	RolloutGeneration int64 `json:"rolloutGeneration,omitempty"`
+
+	// PodStatuses, ServiceStatus and ReplicaSetStatus are aggregated from
+	// the live state of owned children by the status sub-controllers in
+	// status_controllers.go, not by WebAppReconciler itself (see URL above
+	// for the same treatment of Ingress).
+	PodStatuses      []PodStatus       `json:"podStatuses,omitempty"`
+	ServiceStatus    *ServiceStatus    `json:"serviceStatus,omitempty"`
+	ReplicaSetStatus *ReplicaSetStatus `json:"replicaSetStatus,omitempty"`
+}
+
+// PodStatus summarizes the live state of a single Pod owned by a WebApp.
+type PodStatus struct {
+	Name           string `json:"name"`
+	Phase          string `json:"phase"`
+	RestartCount   int32  `json:"restartCount"`
+	ContainerImage string `json:"containerImage,omitempty"`
+}
+
+// ServiceStatus summarizes the live state of the Service owned by a WebApp.
+type ServiceStatus struct {
+	ClusterIP string  `json:"clusterIP,omitempty"`
+	Ports     []int32 `json:"ports,omitempty"`
+}
+
+// ReplicaSetStatus summarizes the live state of the current ReplicaSet
+// backing a WebApp's Deployment.
+type ReplicaSetStatus struct {
+	Name          string `json:"name"`
+	Replicas      int32  `json:"replicas"`
+	ReadyReplicas int32  `json:"readyReplicas"`
 }
 
 type WebAppList struct {
@@ -123,75 +205,164 @@ func (r *WebAppReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, err
 	}
 
-	// STEP 2: Check if Deployment exists, create if not
-	deployment := &appsv1.Deployment{}
-	err = r.Get(ctx, types.NamespacedName{Name: webapp.Name, Namespace: webapp.Namespace}, deployment)
-	if err != nil && errors.IsNotFound(err) {
-		// Create new Deployment
-		dep := r.deploymentForWebApp(webapp)
-		log.Info("Creating a new Deployment", "Deployment.Namespace", dep.Namespace, "Deployment.Name", dep.Name)
-		err = r.Create(ctx, dep)
-		if err != nil {
-			log.Error(err, "Failed to create new Deployment")
-			return ctrl.Result{}, err
+	// STEP 2: Reconcile the Deployment via server-side apply. Re-applying
+	// the full desired object every pass converges image, env, ports,
+	// labels and replicas, not just whichever field a hand-written diff
+	// happens to check.
+	deployment := r.deploymentForWebApp(webapp)
+	if err = applyResource(ctx, r.Client, deployment); err != nil {
+		if errors.IsConflict(err) {
+			if conflictErr := r.surfaceApplyConflict(ctx, webapp, "Deployment", err); conflictErr != nil {
+				log.Error(conflictErr, "Failed to record Deployment apply conflict")
+				return ctrl.Result{}, conflictErr
+			}
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 		}
-		// Deployment created successfully - requeue to update status
-		return ctrl.Result{Requeue: true}, nil
-	} else if err != nil {
-		log.Error(err, "Failed to get Deployment")
+		log.Error(err, "Failed to apply Deployment")
 		return ctrl.Result{}, err
 	}
 
-	// STEP 3: Ensure Deployment matches the spec (reconcile drift)
-	if *deployment.Spec.Replicas != webapp.Spec.Replicas {
-		log.Info("Deployment replicas do not match spec, updating",
-			"current", *deployment.Spec.Replicas,
-			"desired", webapp.Spec.Replicas)
-		deployment.Spec.Replicas = &webapp.Spec.Replicas
-		err = r.Update(ctx, deployment)
-		if err != nil {
-			log.Error(err, "Failed to update Deployment")
+	// STEP 3: Reconcile the Service via server-side apply
+	if err = applyResource(ctx, r.Client, r.serviceForWebApp(webapp)); err != nil {
+		if errors.IsConflict(err) {
+			if conflictErr := r.surfaceApplyConflict(ctx, webapp, "Service", err); conflictErr != nil {
+				log.Error(conflictErr, "Failed to record Service apply conflict")
+				return ctrl.Result{}, conflictErr
+			}
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+		log.Error(err, "Failed to apply Service")
+		return ctrl.Result{}, err
+	}
+
+	// STEP 4: Reconcile Ingress (optional)
+	if webapp.Spec.Ingress != nil {
+		if secretName := webapp.Spec.Ingress.TLSSecretName; secretName != "" {
+			secret := &corev1.Secret{}
+			err = r.Get(ctx, types.NamespacedName{Name: secretName, Namespace: webapp.Namespace}, secret)
+			if err != nil && errors.IsNotFound(err) {
+				log.Info("TLS secret not found, marking Ingress pending", "secret", secretName)
+				meta.SetStatusCondition(&webapp.Status.Conditions, metav1.Condition{
+					Type:    "IngressPending",
+					Status:  metav1.ConditionTrue,
+					Reason:  "TLSSecretMissing",
+					Message: fmt.Sprintf("TLS secret %q not found", secretName),
+				})
+				if err = r.Status().Update(ctx, webapp); err != nil {
+					log.Error(err, "Failed to update WebApp status")
+					return ctrl.Result{}, err
+				}
+				return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+			} else if err != nil {
+				log.Error(err, "Failed to get TLS secret")
+				return ctrl.Result{}, err
+			}
+		}
+
+		// Apply (not just create) so editing host/path/TLS/class/
+		// annotations after the Ingress already exists actually takes
+		// effect, the same way Deployment/Service drift is reconciled.
+		ingress := r.ingressForWebApp(webapp)
+		if err = applyResource(ctx, r.Client, ingress); err != nil {
+			if errors.IsConflict(err) {
+				if conflictErr := r.surfaceApplyConflict(ctx, webapp, "Ingress", err); conflictErr != nil {
+					log.Error(conflictErr, "Failed to record Ingress apply conflict")
+					return ctrl.Result{}, conflictErr
+				}
+				return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+			}
+			log.Error(err, "Failed to apply Ingress")
 			return ctrl.Result{}, err
 		}
-		return ctrl.Result{Requeue: true}, nil
-	}
-
-	// STEP 4: Check if Service exists, create if not
-	service := &corev1.Service{}
-	err = r.Get(ctx, types.NamespacedName{Name: webapp.Name, Namespace: webapp.Namespace}, service)
-	if err != nil && errors.IsNotFound(err) {
-		// Create new Service
-		svc := r.serviceForWebApp(webapp)
-		log.Info("Creating a new Service", "Service.Namespace", svc.Namespace, "Service.Name", svc.Name)
-		err = r.Create(ctx, svc)
-		if err != nil {
-			log.Error(err, "Failed to create new Service")
+
+		meta.RemoveStatusCondition(&webapp.Status.Conditions, "IngressPending")
+		// Status.URL itself is aggregated from the live Ingress by
+		// IngressStatusReconciler (status_controllers.go), not here.
+	} else {
+		// Ingress was removed from the spec: delete the child Ingress we
+		// previously created. IngressStatusReconciler clears the now-stale
+		// Status.URL once it observes the delete.
+		existing := &networkingv1.Ingress{}
+		err = r.Get(ctx, types.NamespacedName{Name: webapp.Name, Namespace: webapp.Namespace}, existing)
+		if err == nil {
+			log.Info("Deleting Ingress no longer requested by spec", "Ingress.Namespace", existing.Namespace, "Ingress.Name", existing.Name)
+			if err = r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+				log.Error(err, "Failed to delete Ingress")
+				return ctrl.Result{}, err
+			}
+			meta.RemoveStatusCondition(&webapp.Status.Conditions, "IngressPending")
+		} else if !errors.IsNotFound(err) {
+			log.Error(err, "Failed to get Ingress")
 			return ctrl.Result{}, err
 		}
-		return ctrl.Result{Requeue: true}, nil
-	} else if err != nil {
-		log.Error(err, "Failed to get Service")
-		return ctrl.Result{}, err
 	}
 
 	// STEP 5: Update status
 	webapp.Status.AvailableReplicas = deployment.Status.AvailableReplicas
 
-	// Update conditions
-	condition := metav1.Condition{
-		Type:               "Ready",
-		Status:             metav1.ConditionTrue,
-		Reason:             "DeploymentReady",
-		Message:            fmt.Sprintf("Deployment has %d/%d replicas available", deployment.Status.AvailableReplicas, webapp.Spec.Replicas),
-		LastTransitionTime: metav1.Now(),
+	rollout := evaluateRollout(deployment)
+	if rollout.Ready {
+		var pods corev1.PodList
+		if err = r.List(ctx, &pods, client.InNamespace(webapp.Namespace), client.MatchingFields{ownerAppIndex: webapp.Name}); err != nil {
+			log.Error(err, "Failed to list Pods for readiness check")
+			return ctrl.Result{}, err
+		}
+		if issues := findPodIssues(pods.Items); len(issues) > 0 {
+			rollout = rolloutStatus{Reason: "PodIssues", Message: formatPodIssues(issues)}
+		}
 	}
 
-	if deployment.Status.AvailableReplicas != webapp.Spec.Replicas {
-		condition.Status = metav1.ConditionFalse
-		condition.Reason = "DeploymentNotReady"
-	}
+	if rollout.Ready {
+		webapp.Status.RolloutStartedAt = nil
+		webapp.Status.RolloutGeneration = 0
+		meta.SetStatusCondition(&webapp.Status.Conditions, metav1.Condition{
+			Type:    "Ready",
+			Status:  metav1.ConditionTrue,
+			Reason:  "RolloutComplete",
+			Message: fmt.Sprintf("Deployment has %d/%d replicas available", deployment.Status.AvailableReplicas, webapp.Spec.Replicas),
+		})
+		meta.RemoveStatusCondition(&webapp.Status.Conditions, "Progressing")
+		meta.RemoveStatusCondition(&webapp.Status.Conditions, "Degraded")
+	} else {
+		// A new rollout (dep.Generation bumped) always restarts the timer,
+		// even if the previous rollout was still mid-flight or already
+		// Degraded, so it isn't charged for a prior rollout's elapsed time.
+		if webapp.Status.RolloutStartedAt == nil || webapp.Status.RolloutGeneration != deployment.Generation {
+			now := metav1.Now()
+			webapp.Status.RolloutStartedAt = &now
+			webapp.Status.RolloutGeneration = deployment.Generation
+		}
 
-	webapp.Status.Conditions = []metav1.Condition{condition}
+		timeout := webapp.Spec.RolloutTimeout.Duration
+		if timeout == 0 {
+			timeout = defaultRolloutTimeout
+		}
+		degraded := time.Since(webapp.Status.RolloutStartedAt.Time) > timeout
+
+		meta.SetStatusCondition(&webapp.Status.Conditions, metav1.Condition{
+			Type:    "Ready",
+			Status:  metav1.ConditionFalse,
+			Reason:  rollout.Reason,
+			Message: rollout.Message,
+		})
+		if degraded {
+			meta.SetStatusCondition(&webapp.Status.Conditions, metav1.Condition{
+				Type:    "Degraded",
+				Status:  metav1.ConditionTrue,
+				Reason:  rollout.Reason,
+				Message: fmt.Sprintf("Rollout exceeded timeout of %s: %s", timeout, rollout.Message),
+			})
+			meta.RemoveStatusCondition(&webapp.Status.Conditions, "Progressing")
+		} else {
+			meta.SetStatusCondition(&webapp.Status.Conditions, metav1.Condition{
+				Type:    "Progressing",
+				Status:  metav1.ConditionTrue,
+				Reason:  rollout.Reason,
+				Message: rollout.Message,
+			})
+			meta.RemoveStatusCondition(&webapp.Status.Conditions, "Degraded")
+		}
+	}
 
 	err = r.Status().Update(ctx, webapp)
 	if err != nil {
@@ -199,12 +370,28 @@ func (r *WebAppReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, err
 	}
 
-	log.Info("Reconciliation complete", "availableReplicas", webapp.Status.AvailableReplicas)
+	log.Info("Reconciliation complete", "availableReplicas", webapp.Status.AvailableReplicas, "ready", rollout.Ready)
 
 	// Requeue after 30 seconds to check status periodically
 	return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 }
 
+// surfaceApplyConflict records a server-side-apply field conflict (another
+// field manager owns a field this operator also manages) as a condition on
+// the WebApp instead of returning the error for an immediate backoff retry.
+// Callers then requeue after a fixed 30 seconds, so the apply is retried at
+// that pace until the conflict is gone. Callers should only invoke this
+// after confirming err is a conflict error.
+func (r *WebAppReconciler) surfaceApplyConflict(ctx context.Context, webapp *WebApp, resource string, err error) error {
+	meta.SetStatusCondition(&webapp.Status.Conditions, metav1.Condition{
+		Type:    "Ready",
+		Status:  metav1.ConditionFalse,
+		Reason:  "ApplyConflict",
+		Message: fmt.Sprintf("server-side apply of %s conflicts with another field manager: %v", resource, err),
+	})
+	return r.Status().Update(ctx, webapp)
+}
+
 // deploymentForWebApp creates a Deployment from the WebApp spec
 func (r *WebAppReconciler) deploymentForWebApp(webapp *WebApp) *appsv1.Deployment {
 	labels := map[string]string{
@@ -228,6 +415,13 @@ func (r *WebAppReconciler) deploymentForWebApp(webapp *WebApp) *appsv1.Deploymen
 	}
 
 	dep := &appsv1.Deployment{
+		// TypeMeta must be set explicitly: server-side apply serializes
+		// this object as-is and the API server rejects an apply patch
+		// without apiVersion/kind.
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      webapp.Name,
 			Namespace: webapp.Namespace,
@@ -257,6 +451,16 @@ func (r *WebAppReconciler) deploymentForWebApp(webapp *WebApp) *appsv1.Deploymen
 		},
 	}
 
+	if us := webapp.Spec.UpdateStrategy; us != nil {
+		dep.Spec.Strategy = appsv1.DeploymentStrategy{
+			Type: appsv1.RollingUpdateDeploymentStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDeployment{
+				MaxSurge:       us.MaxSurge,
+				MaxUnavailable: us.MaxUnavailable,
+			},
+		}
+	}
+
 	// Set WebApp instance as the owner and controller
 	controllerutil.SetControllerReference(webapp, dep, r.Scheme)
 	return dep
@@ -275,6 +479,10 @@ func (r *WebAppReconciler) serviceForWebApp(webapp *WebApp) *corev1.Service {
 	}
 
 	svc := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Service",
+		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      webapp.Name,
 			Namespace: webapp.Namespace,
@@ -296,12 +504,86 @@ func (r *WebAppReconciler) serviceForWebApp(webapp *WebApp) *corev1.Service {
 	return svc
 }
 
+// ingressForWebApp creates an Ingress for the WebApp from its Ingress spec.
+// Callers must ensure webapp.Spec.Ingress is non-nil.
+func (r *WebAppReconciler) ingressForWebApp(webapp *WebApp) *networkingv1.Ingress {
+	labels := map[string]string{
+		"app":        webapp.Name,
+		"managed-by": "webapp-operator",
+	}
+
+	path := webapp.Spec.Ingress.Path
+	if path == "" {
+		path = "/"
+	}
+	pathType := networkingv1.PathTypePrefix
+
+	ing := &networkingv1.Ingress{
+		// TypeMeta must be set explicitly: server-side apply serializes
+		// this object as-is and the API server rejects an apply patch
+		// without apiVersion/kind.
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "networking.k8s.io/v1",
+			Kind:       "Ingress",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        webapp.Name,
+			Namespace:   webapp.Namespace,
+			Labels:      labels,
+			Annotations: webapp.Spec.Ingress.Annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: webapp.Spec.Ingress.IngressClassName,
+			Rules: []networkingv1.IngressRule{{
+				Host: webapp.Spec.Ingress.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     path,
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: webapp.Name,
+									Port: networkingv1.ServiceBackendPort{
+										Number: 80,
+									},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+
+	if webapp.Spec.Ingress.TLSSecretName != "" {
+		ing.Spec.TLS = []networkingv1.IngressTLS{{
+			Hosts:      []string{webapp.Spec.Ingress.Host},
+			SecretName: webapp.Spec.Ingress.TLSSecretName,
+		}}
+	}
+
+	controllerutil.SetControllerReference(webapp, ing, r.Scheme)
+	return ing
+}
+
 // SetupWithManager sets up the controller with the Manager
 func (r *WebAppReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&WebApp{}).
-		Owns(&appsv1.Deployment{}).
+		// Deployments are only needed to trigger reconciles and filter by
+		// owner reference, so watch them metadata-only and keep the full
+		// specs out of the controller cache; Reconcile applies the full
+		// desired Deployment via SSA instead of reading the cached copy.
+		// Services are NOT watched metadata-only here: ServiceStatusReconciler
+		// (status_controllers.go) already registers a full-object watch on
+		// the same GVK from this manager, so a second, metadata-only watch
+		// would only add a redundant informer without saving any memory.
+		// Ingress is watched in full for the same reason; IngressStatusReconciler
+		// registers its own full-object watch to aggregate Status.URL.
+		Owns(&appsv1.Deployment{}, builder.OnlyMetadata).
 		Owns(&corev1.Service{}).
+		Owns(&networkingv1.Ingress{}).
 		Complete(r)
 }
 
@@ -344,6 +626,10 @@ func main() {
 		setupLog.Error(err, "unable to add corev1 to scheme")
 		os.Exit(1)
 	}
+	if err := networkingv1.AddToScheme(mgr.GetScheme()); err != nil {
+		setupLog.Error(err, "unable to add networkingv1 to scheme")
+		os.Exit(1)
+	}
 
 	// Setup reconciler
 	if err = (&WebAppReconciler{
@@ -354,6 +640,24 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Setup child-resource status aggregators
+	if err = (&PodStatusReconciler{Client: mgr.GetClient()}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PodStatus")
+		os.Exit(1)
+	}
+	if err = (&ServiceStatusReconciler{Client: mgr.GetClient()}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ServiceStatus")
+		os.Exit(1)
+	}
+	if err = (&ReplicaSetStatusReconciler{Client: mgr.GetClient()}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ReplicaSetStatus")
+		os.Exit(1)
+	}
+	if err = (&IngressStatusReconciler{Client: mgr.GetClient()}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IngressStatus")
+		os.Exit(1)
+	}
+
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// defaultRolloutTimeout is used when a WebApp does not set
+// spec.rolloutTimeout.
+const defaultRolloutTimeout = 5 * time.Minute
+
+// crashWaitingReasons are container waiting reasons that findPodIssues
+// reports as blocking a rollout. They keep the WebApp Progressing like any
+// other pod issue; it turns Degraded only once the rollout timeout passes.
+var crashWaitingReasons = map[string]bool{
+	"CrashLoopBackOff": true,
+	"ImagePullBackOff": true,
+	"ErrImagePull":     true,
+}
+
+// rolloutStatus is the result of evaluating whether a Deployment's rollout
+// has completed, is still progressing, or has stalled.
+type rolloutStatus struct {
+	Ready   bool
+	Reason  string
+	Message string
+}
+
+// evaluateRollout reports whether dep's rollout is complete, modeled on
+// Helm's waitForResources: the Deployment controller must have observed the
+// latest spec, rolled every replica to the new version, and have no old
+// Pods left running before the rollout is considered healthy.
+func evaluateRollout(dep *appsv1.Deployment) rolloutStatus {
+	desired := *dep.Spec.Replicas
+	status := dep.Status
+
+	if status.ObservedGeneration < dep.Generation {
+		return rolloutStatus{
+			Reason:  "ObservedGenerationOutdated",
+			Message: fmt.Sprintf("waiting for deployment spec to be observed: have %d, want %d", status.ObservedGeneration, dep.Generation),
+		}
+	}
+	if status.UpdatedReplicas != desired {
+		return rolloutStatus{
+			Reason:  "RollingOut",
+			Message: fmt.Sprintf("waiting for rollout: %d/%d replicas updated", status.UpdatedReplicas, desired),
+		}
+	}
+	if status.Replicas != status.UpdatedReplicas {
+		return rolloutStatus{
+			Reason:  "OldReplicasPending",
+			Message: fmt.Sprintf("waiting for old replicas to terminate: %d remaining", status.Replicas-status.UpdatedReplicas),
+		}
+	}
+	if status.AvailableReplicas != desired {
+		return rolloutStatus{
+			Reason:  "ReplicasUnavailable",
+			Message: fmt.Sprintf("waiting for replicas to become available: %d/%d available", status.AvailableReplicas, desired),
+		}
+	}
+	return rolloutStatus{Ready: true}
+}
+
+// podIssue names a specific Pod/container blocking a rollout.
+type podIssue struct {
+	PodName       string
+	ContainerName string
+	Reason        string
+}
+
+// findPodIssues inspects pods for problems blocking a rollout: a Running Pod
+// whose Ready condition is not currently true, or a container waiting in a
+// crash/image-pull backoff. Pods that are not yet Running are not reported.
+func findPodIssues(pods []corev1.Pod) []podIssue {
+	var issues []podIssue
+	for _, pod := range pods {
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Waiting != nil && crashWaitingReasons[cs.State.Waiting.Reason] {
+				issues = append(issues, podIssue{PodName: pod.Name, ContainerName: cs.Name, Reason: cs.State.Waiting.Reason})
+			}
+		}
+
+		ready := false
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+				ready = true
+				break
+			}
+		}
+		if !ready && pod.Status.Phase == corev1.PodRunning {
+			issues = append(issues, podIssue{PodName: pod.Name, Reason: "PodNotReady"})
+		}
+	}
+	return issues
+}
+
+// formatPodIssues renders issues into a single Condition-friendly message.
+func formatPodIssues(issues []podIssue) string {
+	parts := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		if issue.ContainerName != "" {
+			parts = append(parts, fmt.Sprintf("%s/%s: %s", issue.PodName, issue.ContainerName, issue.Reason))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", issue.PodName, issue.Reason))
+	}
+	return "pod issues blocking rollout: " + strings.Join(parts, ", ")
+}
@@ -0,0 +1,310 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// This file aggregates the live status of WebApp children (Pods, Services,
+// ReplicaSets, Ingresses) into WebAppStatus. Modeled on the ONAP monitor operator: one
+// dedicated, narrowly-scoped controller per child kind, driven off a
+// label predicate, rather than re-listing every child kind on every
+// WebApp reconcile. WebAppReconciler stays focused on desired-state
+// (create/update the children); these controllers only read and report.
+const (
+	labelManagedBy = "managed-by"
+	labelApp       = "app"
+	managedByValue = "webapp-operator"
+
+	// ownerAppIndex is the field index key used to look up a WebApp's
+	// children in O(1) instead of listing and filtering the whole
+	// namespace on every event.
+	ownerAppIndex = ".metadata.labels.app"
+)
+
+// managedByPredicate filters child-resource events down to objects carrying
+// the "managed-by=webapp-operator" label, so Pods/Services/ReplicaSets that
+// have nothing to do with this operator never trigger these controllers.
+var managedByPredicate = predicate.NewPredicateFuncs(func(obj client.Object) bool {
+	return obj.GetLabels()[labelManagedBy] == managedByValue
+})
+
+// indexByOwnerApp registers a field index on obj keyed by its "app" label,
+// scoped to objects managed by this operator, so owner lookups are a single
+// indexed List rather than a namespace-wide scan.
+func indexByOwnerApp(mgr ctrl.Manager, obj client.Object) error {
+	return mgr.GetFieldIndexer().IndexField(context.Background(), obj, ownerAppIndex, func(o client.Object) []string {
+		labels := o.GetLabels()
+		if labels[labelManagedBy] != managedByValue {
+			return nil
+		}
+		if app, ok := labels[labelApp]; ok {
+			return []string{app}
+		}
+		return nil
+	})
+}
+
+// enqueueOwningWebApp maps a child object to a reconcile request keyed by
+// its owning WebApp's name/namespace, read off the "app" label. Using this
+// as the watch's event handler (instead of the default
+// EnqueueRequestForObject) means delete events still resolve to the right
+// owner: the handler runs against the object the informer had cached right
+// before the delete, so the label is still there even though a Get against
+// the request's NamespacedName would now 404.
+func enqueueOwningWebApp(_ context.Context, obj client.Object) []reconcile.Request {
+	appName, ok := obj.GetLabels()[labelApp]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: appName, Namespace: obj.GetNamespace()}}}
+}
+
+// patchWebAppStatus looks up the WebApp named appName in namespace and
+// applies mutate to its status, retrying on update conflicts since the Pod,
+// Service and ReplicaSet status controllers all patch the same WebApp
+// independently and concurrently.
+func patchWebAppStatus(ctx context.Context, c client.Client, namespace, appName string, mutate func(*WebAppStatus)) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		webapp := &WebApp{}
+		if err := c.Get(ctx, types.NamespacedName{Name: appName, Namespace: namespace}, webapp); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		mutate(&webapp.Status)
+		return c.Status().Update(ctx, webapp)
+	})
+}
+
+// PodStatusReconciler aggregates the Pods owned by a WebApp into
+// WebAppStatus.PodStatuses. req is keyed by the owning WebApp (see
+// enqueueOwningWebApp), not by the Pod that triggered the event, so a Pod
+// delete re-lists and prunes the stale entry instead of being dropped.
+type PodStatusReconciler struct {
+	client.Client
+}
+
+func (r *PodStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	var pods corev1.PodList
+	if err := r.List(ctx, &pods, client.InNamespace(req.Namespace), client.MatchingFields{ownerAppIndex: req.Name}); err != nil {
+		log.Error(err, "Failed to list owned Pods")
+		return ctrl.Result{}, err
+	}
+
+	statuses := make([]PodStatus, 0, len(pods.Items))
+	for _, p := range pods.Items {
+		ps := PodStatus{
+			Name:  p.Name,
+			Phase: string(p.Status.Phase),
+		}
+		if len(p.Status.ContainerStatuses) > 0 {
+			ps.RestartCount = p.Status.ContainerStatuses[0].RestartCount
+			ps.ContainerImage = p.Status.ContainerStatuses[0].Image
+		}
+		statuses = append(statuses, ps)
+	}
+
+	if err := patchWebAppStatus(ctx, r.Client, req.Namespace, req.Name, func(status *WebAppStatus) {
+		status.PodStatuses = statuses
+	}); err != nil {
+		log.Error(err, "Failed to patch WebApp status with Pod statuses", "webapp", req.Name)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *PodStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := indexByOwnerApp(mgr, &corev1.Pod{}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("pod-status").
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(enqueueOwningWebApp), builder.WithPredicates(managedByPredicate)).
+		Complete(r)
+}
+
+// ServiceStatusReconciler aggregates the Service owned by a WebApp into
+// WebAppStatus.ServiceStatus. req is keyed by the owning WebApp, not the
+// Service, for the same reason as PodStatusReconciler.
+type ServiceStatusReconciler struct {
+	client.Client
+}
+
+func (r *ServiceStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	svc := &corev1.Service{}
+	if err := r.Get(ctx, types.NamespacedName{Name: req.Name, Namespace: req.Namespace}, svc); err != nil {
+		if errors.IsNotFound(err) {
+			if err := patchWebAppStatus(ctx, r.Client, req.Namespace, req.Name, func(s *WebAppStatus) {
+				s.ServiceStatus = nil
+			}); err != nil {
+				log.Error(err, "Failed to clear WebApp Service status", "webapp", req.Name)
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	ports := make([]int32, 0, len(svc.Spec.Ports))
+	for _, p := range svc.Spec.Ports {
+		ports = append(ports, p.Port)
+	}
+	status := &ServiceStatus{
+		ClusterIP: svc.Spec.ClusterIP,
+		Ports:     ports,
+	}
+
+	if err := patchWebAppStatus(ctx, r.Client, req.Namespace, req.Name, func(s *WebAppStatus) {
+		s.ServiceStatus = status
+	}); err != nil {
+		log.Error(err, "Failed to patch WebApp status with Service status", "webapp", req.Name)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *ServiceStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := indexByOwnerApp(mgr, &corev1.Service{}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("service-status").
+		Watches(&corev1.Service{}, handler.EnqueueRequestsFromMapFunc(enqueueOwningWebApp), builder.WithPredicates(managedByPredicate)).
+		Complete(r)
+}
+
+// ReplicaSetStatusReconciler aggregates the ReplicaSet currently backing a
+// WebApp's Deployment into WebAppStatus.ReplicaSetStatus. req is keyed by
+// the owning WebApp, not the ReplicaSet, for the same reason as
+// PodStatusReconciler.
+type ReplicaSetStatusReconciler struct {
+	client.Client
+}
+
+func (r *ReplicaSetStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	var replicaSets appsv1.ReplicaSetList
+	if err := r.List(ctx, &replicaSets, client.InNamespace(req.Namespace), client.MatchingFields{ownerAppIndex: req.Name}); err != nil {
+		log.Error(err, "Failed to list owned ReplicaSets")
+		return ctrl.Result{}, err
+	}
+
+	// The current ReplicaSet is the one with the highest Deployment
+	// revision annotation; older ones are kept (scaled down) as rollout
+	// history.
+	var current *appsv1.ReplicaSet
+	var currentRevision int64
+	for i := range replicaSets.Items {
+		rs := &replicaSets.Items[i]
+		revision, _ := strconv.ParseInt(rs.Annotations["deployment.kubernetes.io/revision"], 10, 64)
+		if current == nil || revision > currentRevision {
+			current = rs
+			currentRevision = revision
+		}
+	}
+
+	var status *ReplicaSetStatus
+	if current != nil {
+		status = &ReplicaSetStatus{
+			Name:          current.Name,
+			Replicas:      current.Status.Replicas,
+			ReadyReplicas: current.Status.ReadyReplicas,
+		}
+	}
+
+	if err := patchWebAppStatus(ctx, r.Client, req.Namespace, req.Name, func(s *WebAppStatus) {
+		s.ReplicaSetStatus = status
+	}); err != nil {
+		log.Error(err, "Failed to patch WebApp status with ReplicaSet status", "webapp", req.Name)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *ReplicaSetStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := indexByOwnerApp(mgr, &appsv1.ReplicaSet{}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("replicaset-status").
+		Watches(&appsv1.ReplicaSet{}, handler.EnqueueRequestsFromMapFunc(enqueueOwningWebApp), builder.WithPredicates(managedByPredicate)).
+		Complete(r)
+}
+
+// IngressStatusReconciler aggregates the Ingress owned by a WebApp into
+// WebAppStatus.URL, once its load balancer has been assigned. req is keyed
+// by the owning WebApp, not the Ingress, for the same reason as
+// PodStatusReconciler.
+type IngressStatusReconciler struct {
+	client.Client
+}
+
+func (r *IngressStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	ingress := &networkingv1.Ingress{}
+	if err := r.Get(ctx, types.NamespacedName{Name: req.Name, Namespace: req.Namespace}, ingress); err != nil {
+		if errors.IsNotFound(err) {
+			if err := patchWebAppStatus(ctx, r.Client, req.Namespace, req.Name, func(s *WebAppStatus) {
+				s.URL = ""
+			}); err != nil {
+				log.Error(err, "Failed to clear WebApp URL", "webapp", req.Name)
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	url := ""
+	if len(ingress.Status.LoadBalancer.Ingress) > 0 {
+		lbIngress := ingress.Status.LoadBalancer.Ingress[0]
+		address := lbIngress.Hostname
+		if address == "" {
+			address = lbIngress.IP
+		}
+		if address != "" {
+			url = fmt.Sprintf("http://%s", address)
+		}
+	}
+
+	if err := patchWebAppStatus(ctx, r.Client, req.Namespace, req.Name, func(s *WebAppStatus) {
+		s.URL = url
+	}); err != nil {
+		log.Error(err, "Failed to patch WebApp status with Ingress URL", "webapp", req.Name)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *IngressStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := indexByOwnerApp(mgr, &networkingv1.Ingress{}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("ingress-status").
+		Watches(&networkingv1.Ingress{}, handler.EnqueueRequestsFromMapFunc(enqueueOwningWebApp), builder.WithPredicates(managedByPredicate)).
+		Complete(r)
+}